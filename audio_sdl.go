@@ -0,0 +1,150 @@
+//go:build !raylib
+
+package eff
+
+import (
+	"github.com/veandco/go-sdl2/mix"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	audioFrequency = 44100
+	audioChannels  = 2
+	audioChunkSize = 2048
+)
+
+// Audio describes the sound effect and music playback methods an SDLCanvas
+// exposes once EnableAudio has been called. It's the missing half of a
+// "canvas-and-drawables" toy engine, alongside Canvas.
+type Audio interface {
+	LoadSound(path string) (Sound, error)
+	LoadMusic(path string) (Music, error)
+	PlaySound(s Sound, loops int)
+	PlayMusic(m Music, loops int)
+	SetVolume(volume int)
+	PauseMusic()
+	ResumeMusic()
+	StopMusic()
+}
+
+// Sound a short sound effect loaded via SDLCanvas.LoadSound, played with PlaySound
+type Sound struct {
+	chunk *mix.Chunk
+}
+
+// Music a longer music track loaded via SDLCanvas.LoadMusic, played with PlayMusic
+type Music struct {
+	music *mix.Music
+}
+
+// EnableAudio turns on sound effect and music playback for the next call to
+// Run, which initializes sdl.INIT_AUDIO and opens the mixer. Audio is opt-in
+// so tests and headless environments without a sound device can skip it.
+func (sdlCanvas *SDLCanvas) EnableAudio() {
+	sdlCanvas.audioEnabled = true
+}
+
+// initAudio initializes sdl.INIT_AUDIO and opens the mixer. Called from run
+// when audio is enabled.
+func initAudio() error {
+	if err := sdl.InitSubSystem(sdl.INIT_AUDIO); err != nil {
+		return err
+	}
+
+	if err := mix.OpenAudio(audioFrequency, mix.DEFAULT_FORMAT, audioChannels, audioChunkSize); err != nil {
+		sdl.QuitSubSystem(sdl.INIT_AUDIO)
+		return err
+	}
+
+	return nil
+}
+
+// closeAudio frees every Sound and Music track loaded via LoadSound/LoadMusic
+// before closing the mixer and sdl.INIT_AUDIO. Called from run on shutdown.
+func (sdlCanvas *SDLCanvas) closeAudio() {
+	for _, sound := range sdlCanvas.sounds {
+		sound.chunk.Free()
+	}
+	for _, music := range sdlCanvas.music {
+		music.music.Free()
+	}
+	mix.CloseAudio()
+	sdl.QuitSubSystem(sdl.INIT_AUDIO)
+}
+
+// LoadSound loads a short sound effect from disk
+func (sdlCanvas *SDLCanvas) LoadSound(path string) (Sound, error) {
+	var sound Sound
+
+	err := DoErr(func() error {
+		chunk, err := mix.LoadWAV(path)
+		if err != nil {
+			return err
+		}
+		sound = Sound{chunk: chunk}
+		sdlCanvas.sounds = append(sdlCanvas.sounds, sound)
+		return nil
+	})
+
+	return sound, err
+}
+
+// LoadMusic loads a music track from disk
+func (sdlCanvas *SDLCanvas) LoadMusic(path string) (Music, error) {
+	var music Music
+
+	err := DoErr(func() error {
+		mixMusic, err := mix.LoadMUS(path)
+		if err != nil {
+			return err
+		}
+		music = Music{music: mixMusic}
+		sdlCanvas.music = append(sdlCanvas.music, music)
+		return nil
+	})
+
+	return music, err
+}
+
+// PlaySound plays a sound effect once and then repeats it loops more times, or forever if loops is -1
+func (sdlCanvas *SDLCanvas) PlaySound(s Sound, loops int) {
+	Do(func() {
+		s.chunk.Play(-1, loops)
+	})
+}
+
+// PlayMusic plays a music track, replacing whatever is currently playing, looping loops times or forever if loops is -1
+func (sdlCanvas *SDLCanvas) PlayMusic(m Music, loops int) {
+	Do(func() {
+		m.music.Play(loops)
+	})
+}
+
+// SetVolume sets the volume, from 0 to mix.MAX_VOLUME, for both sound effects and music
+func (sdlCanvas *SDLCanvas) SetVolume(volume int) {
+	Do(func() {
+		mix.Volume(-1, volume)
+		mix.VolumeMusic(volume)
+	})
+}
+
+// PauseMusic pauses the currently playing music track
+func (sdlCanvas *SDLCanvas) PauseMusic() {
+	Do(func() {
+		mix.PauseMusic()
+	})
+}
+
+// ResumeMusic resumes a paused music track
+func (sdlCanvas *SDLCanvas) ResumeMusic() {
+	Do(func() {
+		mix.ResumeMusic()
+	})
+}
+
+// StopMusic stops the currently playing music track
+func (sdlCanvas *SDLCanvas) StopMusic() {
+	Do(func() {
+		mix.HaltMusic()
+	})
+}
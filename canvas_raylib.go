@@ -0,0 +1,294 @@
+//go:build raylib
+
+package eff
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// NewCanvas constructs the Canvas implementation selected at build time. This
+// build uses RaylibCanvas; drop the raylib build tag to get SDLCanvas instead.
+func NewCanvas() Canvas {
+	return &RaylibCanvas{}
+}
+
+// RaylibCanvas creates a raylib window and calls all drawable methods. It
+// shares the Drawable, Point, Color, Rect, and Texture types with SDLCanvas,
+// so the same drawables run against either backend.
+type RaylibCanvas struct {
+	drawables []Drawable
+	width     int
+	height    int
+	tickRate  int
+	targetFPS int
+
+	lastMouse      Point
+	lastAxisValues map[ControllerAxis]float32
+}
+
+// SetWidth set the width of the canvas, must be called prior to run
+func (rlCanvas *RaylibCanvas) SetWidth(width int) {
+	rlCanvas.width = width
+}
+
+// GetWidth get the width of the canvas window
+func (rlCanvas *RaylibCanvas) GetWidth() int {
+	return rlCanvas.width
+}
+
+// SetHeight set the height of the canvas, must be called prior to run
+func (rlCanvas *RaylibCanvas) SetHeight(height int) {
+	rlCanvas.height = height
+}
+
+// GetHeight get the height of the canvas window
+func (rlCanvas *RaylibCanvas) GetHeight() int {
+	return rlCanvas.height
+}
+
+// SetTickRate sets how many times per second UpdateTick is called on
+// TickUpdater drawables, independent of the render frame rate. Must be
+// called prior to Run; defaults to 60Hz.
+func (rlCanvas *RaylibCanvas) SetTickRate(hz int) {
+	rlCanvas.tickRate = hz
+}
+
+// SetTargetFPS sets the render frame rate Run tries to maintain. Must be
+// called prior to Run; defaults to 90Hz.
+func (rlCanvas *RaylibCanvas) SetTargetFPS(hz int) {
+	rlCanvas.targetFPS = hz
+}
+
+// SetRelativeMouseMode enables or disables relative mouse mode, which hides
+// the cursor and locks it to the window. OnMouseMove still receives the
+// cursor position, not movement deltas.
+func (rlCanvas *RaylibCanvas) SetRelativeMouseMode(enabled bool) {
+	Do(func() {
+		if enabled {
+			rl.DisableCursor()
+		} else {
+			rl.EnableCursor()
+		}
+	})
+}
+
+// AddDrawable adds a struct that implements the eff.Drawable interface
+func (rlCanvas *RaylibCanvas) AddDrawable(drawable Drawable) {
+	rlCanvas.drawables = append(rlCanvas.drawables, drawable)
+}
+
+// Run locks the calling goroutine to the OS thread raylib was initialized on,
+// then drives the dispatcher loop until the canvas finishes running.
+// Drawables' Init, Draw, and Update are invoked from a separate goroutine
+// and may freely call Canvas methods without blocking the dispatcher.
+func (rlCanvas *RaylibCanvas) Run() int {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	resultChan := make(chan int, 1)
+	go func() {
+		resultChan <- rlCanvas.run()
+	}()
+
+	for {
+		select {
+		case job := <-doQueue:
+			job()
+		case result := <-resultChan:
+			return result
+		}
+	}
+}
+
+// run is the body of Run, executed on its own goroutine so that every raylib
+// touchpoint can be routed through Do/DoErr onto the dispatcher loop above.
+func (rlCanvas *RaylibCanvas) run() int {
+	rlCanvas.lastAxisValues = make(map[ControllerAxis]float32)
+
+	if rlCanvas.tickRate == 0 {
+		rlCanvas.tickRate = defaultTickRate
+	}
+	if rlCanvas.targetFPS == 0 {
+		rlCanvas.targetFPS = defaultTargetFPS
+	}
+	fixedDt := time.Second / time.Duration(rlCanvas.tickRate)
+
+	Do(func() {
+		rl.InitWindow(int32(rlCanvas.GetWidth()), int32(rlCanvas.GetHeight()), windowTitle)
+		rl.SetTargetFPS(int32(rlCanvas.targetFPS))
+	})
+	defer Do(func() {
+		rl.CloseWindow()
+	})
+
+	// Init Code Goes Here
+	for _, drawable := range rlCanvas.drawables {
+		drawable.Init(rlCanvas)
+	}
+
+	running := true
+	var accumulator time.Duration
+	lastFrameTime := time.Now()
+	for running {
+		frameStart := time.Now()
+		accumulator += frameStart.Sub(lastFrameTime)
+		lastFrameTime = frameStart
+
+		var pendingDispatches []func()
+		Do(func() {
+			if rl.WindowShouldClose() || rl.IsKeyPressed(rl.KeyQ) {
+				running = false
+			}
+			if rl.IsKeyPressed(rl.KeyF) {
+				rl.ToggleFullscreen()
+			}
+
+			pollRaylibKeys(rlCanvas.drawables, &pendingDispatches)
+			pollRaylibMouse(rlCanvas.drawables, &rlCanvas.lastMouse, &pendingDispatches)
+			pollRaylibController(rlCanvas.drawables, rlCanvas.lastAxisValues, &pendingDispatches)
+
+			rl.BeginDrawing()
+			rl.ClearBackground(rl.Black)
+		})
+
+		// Dispatch to handlers here, on the run() goroutine rather than inside
+		// Do above, so a handler is free to call Canvas methods (which
+		// themselves go through Do) without deadlocking against itself.
+		for _, dispatch := range pendingDispatches {
+			dispatch()
+		}
+
+		for substeps := 0; accumulator >= fixedDt && substeps < maxSubsteps; substeps++ {
+			for _, drawable := range rlCanvas.drawables {
+				if tickUpdater, ok := drawable.(TickUpdater); ok {
+					tickUpdater.UpdateTick(rlCanvas, fixedDt)
+				} else {
+					drawable.Update(rlCanvas)
+				}
+			}
+			accumulator -= fixedDt
+		}
+		// Past maxSubsteps, drop the backlog so alpha stays below 1.
+		if accumulator >= fixedDt {
+			accumulator %= fixedDt
+		}
+
+		alpha := float64(accumulator) / float64(fixedDt)
+		for _, drawable := range rlCanvas.drawables {
+			if interpolatedDrawer, ok := drawable.(InterpolatedDrawer); ok {
+				interpolatedDrawer.DrawAlpha(rlCanvas, alpha)
+			} else {
+				drawable.Draw(rlCanvas)
+			}
+		}
+
+		Do(func() {
+			rl.EndDrawing()
+		})
+	}
+	return 0
+}
+
+// DrawPoints draw a slice of points to the screen all the same color
+func (rlCanvas *RaylibCanvas) DrawPoints(points *[]Point, color Color) {
+	Do(func() {
+		rlColor := toRlColor(color)
+		for _, point := range *points {
+			rl.DrawPixel(int32(point.X), int32(point.Y), rlColor)
+		}
+	})
+}
+
+// DrawLines draw a connected sequence of line segments through points, all the same color
+func (rlCanvas *RaylibCanvas) DrawLines(points *[]Point, color Color) {
+	Do(func() {
+		rlColor := toRlColor(color)
+		for i := 1; i < len(*points); i++ {
+			from := (*points)[i-1]
+			to := (*points)[i]
+			rl.DrawLine(int32(from.X), int32(from.Y), int32(to.X), int32(to.Y), rlColor)
+		}
+	})
+}
+
+// DrawRect draw the outline of a rectangle
+func (rlCanvas *RaylibCanvas) DrawRect(rect Rect, color Color) {
+	Do(func() {
+		rl.DrawRectangleLines(int32(rect.X), int32(rect.Y), int32(rect.W), int32(rect.H), toRlColor(color))
+	})
+}
+
+// FillRect draw a filled rectangle
+func (rlCanvas *RaylibCanvas) FillRect(rect Rect, color Color) {
+	Do(func() {
+		rl.DrawRectangle(int32(rect.X), int32(rect.Y), int32(rect.W), int32(rect.H), toRlColor(color))
+	})
+}
+
+// DrawRects draw the outlines of a slice of rectangles all the same color
+func (rlCanvas *RaylibCanvas) DrawRects(rects *[]Rect, color Color) {
+	Do(func() {
+		rlColor := toRlColor(color)
+		for _, rect := range *rects {
+			rl.DrawRectangleLines(int32(rect.X), int32(rect.Y), int32(rect.W), int32(rect.H), rlColor)
+		}
+	})
+}
+
+// FillRects draw a slice of filled rectangles all the same color
+func (rlCanvas *RaylibCanvas) FillRects(rects *[]Rect, color Color) {
+	Do(func() {
+		rlColor := toRlColor(color)
+		for _, rect := range *rects {
+			rl.DrawRectangle(int32(rect.X), int32(rect.Y), int32(rect.W), int32(rect.H), rlColor)
+		}
+	})
+}
+
+// LoadTexture loads an image from disk and uploads it to the GPU as a Texture
+func (rlCanvas *RaylibCanvas) LoadTexture(path string) (Texture, error) {
+	var texture Texture
+
+	err := DoErr(func() error {
+		rlTexture := rl.LoadTexture(path)
+		if rlTexture.ID == 0 {
+			return fmt.Errorf("failed to load texture: %s", path)
+		}
+		texture = Texture{handle: rlTexture}
+		return nil
+	})
+
+	return texture, err
+}
+
+// DrawTexture copies the src region of tex into the dst region of the canvas
+func (rlCanvas *RaylibCanvas) DrawTexture(tex Texture, src Rect, dst Rect) {
+	Do(func() {
+		rlTexture := tex.handle.(rl.Texture2D)
+		rl.DrawTexturePro(rlTexture, toRlRectangle(src), toRlRectangle(dst), rl.Vector2{}, 0, rl.White)
+	})
+}
+
+// toRlColor converts an eff.Color into the rl.Color representation used by raylib-go
+func toRlColor(color Color) rl.Color {
+	return rl.Color{
+		R: uint8(color.R),
+		G: uint8(color.G),
+		B: uint8(color.B),
+		A: uint8(color.A),
+	}
+}
+
+// toRlRectangle converts an eff.Rect into the rl.Rectangle representation used by raylib-go
+func toRlRectangle(rect Rect) rl.Rectangle {
+	return rl.Rectangle{
+		X:      float32(rect.X),
+		Y:      float32(rect.Y),
+		Width:  float32(rect.W),
+		Height: float32(rect.H),
+	}
+}
@@ -0,0 +1,455 @@
+//go:build !raylib
+
+package eff
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// NewCanvas constructs the Canvas implementation selected at build time. This
+// build uses SDLCanvas; build with -tags raylib to get RaylibCanvas instead.
+func NewCanvas() Canvas {
+	return &SDLCanvas{}
+}
+
+// SDLCanvas creates window and renderer and calls all drawable methods
+type SDLCanvas struct {
+	window       *sdl.Window
+	renderer     *sdl.Renderer
+	drawables    []Drawable
+	controllers  []*sdl.GameController
+	sounds       []Sound
+	music        []Music
+	width        int
+	height       int
+	audioEnabled bool
+	tickRate     int
+	targetFPS    int
+}
+
+// SetWidth set the width of the canvas, must be called prior to run
+func (sdlCanvas *SDLCanvas) SetWidth(width int) {
+	sdlCanvas.width = width
+}
+
+// GetWidth get the width of the canvas window
+func (sdlCanvas *SDLCanvas) GetWidth() int {
+	return sdlCanvas.width
+}
+
+// SetHeight set the height of the canvas, must be called prior to run
+func (sdlCanvas *SDLCanvas) SetHeight(height int) {
+	sdlCanvas.height = height
+}
+
+// GetHeight get the height of the canvas window
+func (sdlCanvas *SDLCanvas) GetHeight() int {
+	return sdlCanvas.height
+}
+
+// SetTickRate sets how many times per second UpdateTick is called on
+// TickUpdater drawables, independent of the render frame rate. Must be
+// called prior to Run; defaults to 60Hz.
+func (sdlCanvas *SDLCanvas) SetTickRate(hz int) {
+	sdlCanvas.tickRate = hz
+}
+
+// SetTargetFPS sets the render frame rate Run tries to maintain. Must be
+// called prior to Run; defaults to 90Hz.
+func (sdlCanvas *SDLCanvas) SetTargetFPS(hz int) {
+	sdlCanvas.targetFPS = hz
+}
+
+// SetRelativeMouseMode enables or disables relative mouse mode, which hides
+// the cursor and keeps it inside the window. OnMouseMove still receives the
+// cursor position, not movement deltas.
+func (sdlCanvas *SDLCanvas) SetRelativeMouseMode(enabled bool) {
+	Do(func() {
+		sdl.SetRelativeMouseMode(enabled)
+	})
+}
+
+// AddDrawable adds a struct that implements the eff.Drawable interface
+func (sdlCanvas *SDLCanvas) AddDrawable(drawable Drawable) {
+	sdlCanvas.drawables = append(sdlCanvas.drawables, drawable)
+}
+
+// Run locks the calling goroutine to the OS thread SDL was initialized on,
+// then drives the dispatcher loop until the canvas finishes running.
+// Drawables' Init, Draw, and Update are invoked from a separate goroutine
+// and may freely call Canvas methods without blocking the dispatcher.
+func (sdlCanvas *SDLCanvas) Run() int {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	resultChan := make(chan int, 1)
+	go func() {
+		resultChan <- sdlCanvas.run()
+	}()
+
+	for {
+		select {
+		case job := <-doQueue:
+			job()
+		case result := <-resultChan:
+			return result
+		}
+	}
+}
+
+// run is the body of Run, executed on its own goroutine so that every SDL
+// touchpoint can be routed through Do/DoErr onto the dispatcher loop above.
+func (sdlCanvas *SDLCanvas) run() int {
+	err := DoErr(func() error {
+		var err error
+		sdlCanvas.window, err = sdl.CreateWindow(
+			windowTitle,
+			sdl.WINDOWPOS_UNDEFINED,
+			sdl.WINDOWPOS_UNDEFINED,
+			sdlCanvas.GetWidth(),
+			sdlCanvas.GetHeight(),
+			sdl.WINDOW_OPENGL,
+		)
+		return err
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create window: %s\n", err)
+		return 1
+	}
+	defer Do(func() {
+		sdlCanvas.window.Destroy()
+	})
+
+	err = DoErr(func() error {
+		var err error
+		sdlCanvas.renderer, err = sdl.CreateRenderer(
+			sdlCanvas.window,
+			-1,
+			sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC,
+		)
+		return err
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create renderer: ", err)
+		return 2
+	}
+	defer Do(func() {
+		sdlCanvas.renderer.Destroy()
+	})
+
+	Do(func() {
+		sdlCanvas.renderer.Clear()
+	})
+
+	if sdlCanvas.audioEnabled {
+		err = DoErr(initAudio)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to initialize audio: ", err)
+			return 3
+		}
+		defer Do(sdlCanvas.closeAudio)
+	}
+
+	if err := DoErr(func() error {
+		return sdl.InitSubSystem(sdl.INIT_GAMECONTROLLER)
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to initialize game controller subsystem: ", err)
+	} else {
+		defer Do(func() {
+			for _, controller := range sdlCanvas.controllers {
+				controller.Close()
+			}
+			sdl.QuitSubSystem(sdl.INIT_GAMECONTROLLER)
+		})
+		Do(func() {
+			for i := 0; i < sdl.NumJoysticks(); i++ {
+				sdlCanvas.openController(i)
+			}
+		})
+	}
+
+	// Init Code Goes Here
+	for _, drawable := range sdlCanvas.drawables {
+		drawable.Init(sdlCanvas)
+	}
+
+	if sdlCanvas.tickRate == 0 {
+		sdlCanvas.tickRate = defaultTickRate
+	}
+	if sdlCanvas.targetFPS == 0 {
+		sdlCanvas.targetFPS = defaultTargetFPS
+	}
+	fixedDt := time.Second / time.Duration(sdlCanvas.tickRate)
+	frameBudget := time.Second / time.Duration(sdlCanvas.targetFPS)
+
+	running := true
+	fullscreen := false
+	var accumulator time.Duration
+	lastFrameTime := time.Now()
+	for running {
+		frameStart := time.Now()
+		accumulator += frameStart.Sub(lastFrameTime)
+		lastFrameTime = frameStart
+
+		var pendingDispatches []func()
+		Do(func() {
+			for event := sdl.PollEvent(); event != nil; event = sdl.PollEvent() {
+				switch t := event.(type) {
+				case *sdl.QuitEvent:
+					running = false
+				case *sdl.KeyboardEvent:
+					if t.State == sdl.PRESSED {
+						if t.Repeat == 0 {
+							key := keyFromSym(t.Keysym.Sym)
+							pendingDispatches = append(pendingDispatches, func() {
+								dispatchKeyDown(sdlCanvas.drawables, key)
+							})
+						}
+					} else {
+						switch t.Keysym.Sym {
+						case sdl.K_q:
+							running = false
+						case sdl.K_f:
+							fullscreen = !fullscreen
+							if fullscreen {
+								sdlCanvas.window.SetFullscreen(sdl.WINDOW_FULLSCREEN)
+							} else {
+								sdlCanvas.window.SetFullscreen(0)
+							}
+						}
+						key := keyFromSym(t.Keysym.Sym)
+						pendingDispatches = append(pendingDispatches, func() {
+							dispatchKeyUp(sdlCanvas.drawables, key)
+						})
+					}
+				case *sdl.MouseMotionEvent:
+					point := Point{X: int(t.X), Y: int(t.Y)}
+					pendingDispatches = append(pendingDispatches, func() {
+						dispatchMouseMove(sdlCanvas.drawables, point)
+					})
+				case *sdl.MouseButtonEvent:
+					button := mouseButtonFromSDL(t.Button)
+					pressed := t.State == sdl.PRESSED
+					point := Point{X: int(t.X), Y: int(t.Y)}
+					pendingDispatches = append(pendingDispatches, func() {
+						dispatchMouseButton(sdlCanvas.drawables, button, pressed, point)
+					})
+				case *sdl.ControllerDeviceEvent:
+					if t.Type == sdl.CONTROLLERDEVICEADDED {
+						sdlCanvas.openController(int(t.Which))
+					}
+				case *sdl.ControllerButtonEvent:
+					button := controllerButtonFromSDL(t.Button)
+					pressed := t.State == sdl.PRESSED
+					pendingDispatches = append(pendingDispatches, func() {
+						dispatchControllerButton(sdlCanvas.drawables, button, pressed)
+					})
+				case *sdl.ControllerAxisEvent:
+					axis := controllerAxisFromSDL(t.Axis)
+					value := t.Value
+					pendingDispatches = append(pendingDispatches, func() {
+						dispatchControllerAxis(sdlCanvas.drawables, axis, value)
+					})
+				}
+			}
+
+			sdlCanvas.renderer.SetDrawColor(0, 0, 0, 0xFF)
+			sdlCanvas.renderer.Clear()
+		})
+
+		// Dispatch to handlers here, on the run() goroutine rather than inside
+		// Do above, so a handler is free to call Canvas methods (which
+		// themselves go through Do) without deadlocking against itself.
+		for _, dispatch := range pendingDispatches {
+			dispatch()
+		}
+
+		for substeps := 0; accumulator >= fixedDt && substeps < maxSubsteps; substeps++ {
+			for _, drawable := range sdlCanvas.drawables {
+				if tickUpdater, ok := drawable.(TickUpdater); ok {
+					tickUpdater.UpdateTick(sdlCanvas, fixedDt)
+				} else {
+					drawable.Update(sdlCanvas)
+				}
+			}
+			accumulator -= fixedDt
+		}
+		// Past maxSubsteps, drop the backlog so alpha stays below 1.
+		if accumulator >= fixedDt {
+			accumulator %= fixedDt
+		}
+
+		alpha := float64(accumulator) / float64(fixedDt)
+		for _, drawable := range sdlCanvas.drawables {
+			if interpolatedDrawer, ok := drawable.(InterpolatedDrawer); ok {
+				interpolatedDrawer.DrawAlpha(sdlCanvas, alpha)
+			} else {
+				drawable.Draw(sdlCanvas)
+			}
+		}
+
+		Do(func() {
+			sdlCanvas.renderer.Present()
+		})
+
+		if elapsed := time.Since(frameStart); elapsed < frameBudget {
+			time.Sleep(frameBudget - elapsed)
+		}
+	}
+	return 0
+}
+
+// DrawPoints draw a slice of points to the screen all the same color
+func (sdlCanvas *SDLCanvas) DrawPoints(points *[]Point, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlPoints := make([]sdl.Point, len(*points))
+
+		for i, point := range *points {
+			sdlPoints[i] = sdl.Point{X: int32(point.X), Y: int32(point.Y)}
+		}
+
+		sdlCanvas.renderer.DrawPoints(sdlPoints)
+	})
+}
+
+// DrawLines draw a connected sequence of line segments through points, all the same color
+func (sdlCanvas *SDLCanvas) DrawLines(points *[]Point, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlPoints := make([]sdl.Point, len(*points))
+
+		for i, point := range *points {
+			sdlPoints[i] = sdl.Point{X: int32(point.X), Y: int32(point.Y)}
+		}
+
+		sdlCanvas.renderer.DrawLines(sdlPoints)
+	})
+}
+
+// DrawRect draw the outline of a rectangle
+func (sdlCanvas *SDLCanvas) DrawRect(rect Rect, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlRect := toSdlRect(rect)
+		sdlCanvas.renderer.DrawRect(&sdlRect)
+	})
+}
+
+// FillRect draw a filled rectangle
+func (sdlCanvas *SDLCanvas) FillRect(rect Rect, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlRect := toSdlRect(rect)
+		sdlCanvas.renderer.FillRect(&sdlRect)
+	})
+}
+
+// DrawRects draw the outlines of a slice of rectangles all the same color
+func (sdlCanvas *SDLCanvas) DrawRects(rects *[]Rect, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlCanvas.renderer.DrawRects(toSdlRects(rects))
+	})
+}
+
+// FillRects draw a slice of filled rectangles all the same color
+func (sdlCanvas *SDLCanvas) FillRects(rects *[]Rect, color Color) {
+	Do(func() {
+		sdlCanvas.renderer.SetDrawColor(
+			uint8(color.R),
+			uint8(color.G),
+			uint8(color.B),
+			uint8(color.A),
+		)
+
+		sdlCanvas.renderer.FillRects(toSdlRects(rects))
+	})
+}
+
+// LoadTexture loads an image from disk and uploads it to the GPU as a Texture
+func (sdlCanvas *SDLCanvas) LoadTexture(path string) (Texture, error) {
+	var texture Texture
+
+	err := DoErr(func() error {
+		surface, err := sdl.LoadBMP(path)
+		if err != nil {
+			return err
+		}
+		defer surface.Free()
+
+		sdlTexture, err := sdlCanvas.renderer.CreateTextureFromSurface(surface)
+		if err != nil {
+			return err
+		}
+		texture = Texture{handle: sdlTexture}
+		return nil
+	})
+
+	return texture, err
+}
+
+// DrawTexture copies the src region of tex into the dst region of the canvas
+func (sdlCanvas *SDLCanvas) DrawTexture(tex Texture, src Rect, dst Rect) {
+	Do(func() {
+		sdlSrc := toSdlRect(src)
+		sdlDst := toSdlRect(dst)
+		sdlCanvas.renderer.Copy(tex.handle.(*sdl.Texture), &sdlSrc, &sdlDst)
+	})
+}
+
+// toSdlRect converts an eff.Rect into the sdl.Rect representation used by go-sdl2
+func toSdlRect(rect Rect) sdl.Rect {
+	return sdl.Rect{
+		X: int32(rect.X),
+		Y: int32(rect.Y),
+		W: int32(rect.W),
+		H: int32(rect.H),
+	}
+}
+
+// toSdlRects converts a slice of eff.Rect into the sdl.Rect representation used by go-sdl2
+func toSdlRects(rects *[]Rect) []sdl.Rect {
+	sdlRects := make([]sdl.Rect, len(*rects))
+
+	for i, rect := range *rects {
+		sdlRects[i] = toSdlRect(rect)
+	}
+
+	return sdlRects
+}
@@ -0,0 +1,27 @@
+package eff
+
+// doQueue carries jobs from any goroutine to the thread that owns the SDL
+// context. Only that thread may read from it.
+var doQueue = make(chan func())
+
+// Do submits fn to run on the thread driving Canvas.Run and blocks until it
+// completes. SDL requires most of its calls to happen on the thread that
+// initialized video, so any Drawable wanting to touch SDL (directly, or via
+// a Canvas method) from another goroutine must go through Do or DoErr.
+func Do(fn func()) {
+	done := make(chan struct{})
+	doQueue <- func() {
+		fn()
+		close(done)
+	}
+	<-done
+}
+
+// DoErr is Do for a function that returns an error, propagating it back to the caller.
+func DoErr(fn func() error) error {
+	var err error
+	Do(func() {
+		err = fn()
+	})
+	return err
+}
@@ -0,0 +1,179 @@
+package eff
+
+// Key identifies a keyboard key, independent of any backend's own keycode
+// type so that Drawables don't need to import it directly.
+type Key int32
+
+// Recognized keys. The SDL backend reports keycodes it doesn't recognize as
+// KeyUnknown; the raylib backend only reports the keys listed here.
+const (
+	KeyUnknown Key = iota
+	KeyA
+	KeyB
+	KeyC
+	KeyD
+	KeyE
+	KeyF
+	KeyG
+	KeyH
+	KeyI
+	KeyJ
+	KeyK
+	KeyL
+	KeyM
+	KeyN
+	KeyO
+	KeyP
+	KeyQ
+	KeyR
+	KeyS
+	KeyT
+	KeyU
+	KeyV
+	KeyW
+	KeyX
+	KeyY
+	KeyZ
+	Key0
+	Key1
+	Key2
+	Key3
+	Key4
+	Key5
+	Key6
+	Key7
+	Key8
+	Key9
+	KeySpace
+	KeyEscape
+	KeyReturn
+	KeyTab
+	KeyBackspace
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyLShift
+	KeyRShift
+	KeyLCtrl
+	KeyRCtrl
+	KeyLAlt
+	KeyRAlt
+)
+
+// MouseButton identifies a mouse button.
+type MouseButton int
+
+// Recognized mouse buttons.
+const (
+	MouseButtonUnknown MouseButton = iota
+	MouseButtonLeft
+	MouseButtonMiddle
+	MouseButtonRight
+)
+
+// ControllerButton identifies a game controller button.
+type ControllerButton uint8
+
+// Recognized controller buttons, matching the standard Xbox-style layout.
+const (
+	ControllerButtonUnknown ControllerButton = iota
+	ControllerButtonA
+	ControllerButtonB
+	ControllerButtonX
+	ControllerButtonY
+	ControllerButtonBack
+	ControllerButtonStart
+	ControllerButtonLeftShoulder
+	ControllerButtonRightShoulder
+	ControllerButtonDPadUp
+	ControllerButtonDPadDown
+	ControllerButtonDPadLeft
+	ControllerButtonDPadRight
+)
+
+// ControllerAxis identifies a game controller analog axis.
+type ControllerAxis uint8
+
+// Recognized controller axes.
+const (
+	ControllerAxisUnknown ControllerAxis = iota
+	ControllerAxisLeftX
+	ControllerAxisLeftY
+	ControllerAxisRightX
+	ControllerAxisRightY
+	ControllerAxisTriggerLeft
+	ControllerAxisTriggerRight
+)
+
+// KeyHandler is an optional Drawable interface for receiving keyboard events.
+type KeyHandler interface {
+	OnKeyDown(key Key)
+	OnKeyUp(key Key)
+}
+
+// MouseHandler is an optional Drawable interface for receiving mouse events.
+type MouseHandler interface {
+	OnMouseMove(point Point)
+	OnMouseButton(button MouseButton, pressed bool, point Point)
+}
+
+// ControllerHandler is an optional Drawable interface for receiving game controller events.
+type ControllerHandler interface {
+	OnControllerButton(button ControllerButton, pressed bool)
+	OnControllerAxis(axis ControllerAxis, value int16)
+}
+
+// dispatchKeyDown notifies every KeyHandler drawable of a key press.
+func dispatchKeyDown(drawables []Drawable, key Key) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(KeyHandler); ok {
+			handler.OnKeyDown(key)
+		}
+	}
+}
+
+// dispatchKeyUp notifies every KeyHandler drawable of a key release.
+func dispatchKeyUp(drawables []Drawable, key Key) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(KeyHandler); ok {
+			handler.OnKeyUp(key)
+		}
+	}
+}
+
+// dispatchMouseMove notifies every MouseHandler drawable of a cursor move.
+func dispatchMouseMove(drawables []Drawable, point Point) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(MouseHandler); ok {
+			handler.OnMouseMove(point)
+		}
+	}
+}
+
+// dispatchMouseButton notifies every MouseHandler drawable of a button press or release.
+func dispatchMouseButton(drawables []Drawable, button MouseButton, pressed bool, point Point) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(MouseHandler); ok {
+			handler.OnMouseButton(button, pressed, point)
+		}
+	}
+}
+
+// dispatchControllerButton notifies every ControllerHandler drawable of a button press or release.
+func dispatchControllerButton(drawables []Drawable, button ControllerButton, pressed bool) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(ControllerHandler); ok {
+			handler.OnControllerButton(button, pressed)
+		}
+	}
+}
+
+// dispatchControllerAxis notifies every ControllerHandler drawable of an axis movement.
+func dispatchControllerAxis(drawables []Drawable, axis ControllerAxis, value int16) {
+	for _, drawable := range drawables {
+		if handler, ok := drawable.(ControllerHandler); ok {
+			handler.OnControllerAxis(axis, value)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+//go:build raylib
+
+package eff
+
+import rl "github.com/gen2brain/raylib-go/raylib"
+
+// raylibKeys maps every recognized eff.Key to its raylib keycode. raylib has
+// no event queue for input, so the canvas polls this table once per frame.
+var raylibKeys = map[Key]int32{
+	KeyA:         rl.KeyA,
+	KeyB:         rl.KeyB,
+	KeyC:         rl.KeyC,
+	KeyD:         rl.KeyD,
+	KeyE:         rl.KeyE,
+	KeyF:         rl.KeyF,
+	KeyG:         rl.KeyG,
+	KeyH:         rl.KeyH,
+	KeyI:         rl.KeyI,
+	KeyJ:         rl.KeyJ,
+	KeyK:         rl.KeyK,
+	KeyL:         rl.KeyL,
+	KeyM:         rl.KeyM,
+	KeyN:         rl.KeyN,
+	KeyO:         rl.KeyO,
+	KeyP:         rl.KeyP,
+	KeyQ:         rl.KeyQ,
+	KeyR:         rl.KeyR,
+	KeyS:         rl.KeyS,
+	KeyT:         rl.KeyT,
+	KeyU:         rl.KeyU,
+	KeyV:         rl.KeyV,
+	KeyW:         rl.KeyW,
+	KeyX:         rl.KeyX,
+	KeyY:         rl.KeyY,
+	KeyZ:         rl.KeyZ,
+	Key0:         rl.KeyZero,
+	Key1:         rl.KeyOne,
+	Key2:         rl.KeyTwo,
+	Key3:         rl.KeyThree,
+	Key4:         rl.KeyFour,
+	Key5:         rl.KeyFive,
+	Key6:         rl.KeySix,
+	Key7:         rl.KeySeven,
+	Key8:         rl.KeyEight,
+	Key9:         rl.KeyNine,
+	KeySpace:     rl.KeySpace,
+	KeyEscape:    rl.KeyEscape,
+	KeyReturn:    rl.KeyEnter,
+	KeyTab:       rl.KeyTab,
+	KeyBackspace: rl.KeyBackspace,
+	KeyUp:        rl.KeyUp,
+	KeyDown:      rl.KeyDown,
+	KeyLeft:      rl.KeyLeft,
+	KeyRight:     rl.KeyRight,
+	KeyLShift:    rl.KeyLeftShift,
+	KeyRShift:    rl.KeyRightShift,
+	KeyLCtrl:     rl.KeyLeftControl,
+	KeyRCtrl:     rl.KeyRightControl,
+	KeyLAlt:      rl.KeyLeftAlt,
+	KeyRAlt:      rl.KeyRightAlt,
+}
+
+// raylibMouseButtons maps every recognized eff.MouseButton to its raylib button code.
+var raylibMouseButtons = map[MouseButton]int32{
+	MouseButtonLeft:   rl.MouseLeftButton,
+	MouseButtonMiddle: rl.MouseMiddleButton,
+	MouseButtonRight:  rl.MouseRightButton,
+}
+
+// raylibControllerButtons maps every recognized eff.ControllerButton to its raylib gamepad button code.
+var raylibControllerButtons = map[ControllerButton]int32{
+	ControllerButtonA:             rl.GamepadButtonRightFaceDown,
+	ControllerButtonB:             rl.GamepadButtonRightFaceRight,
+	ControllerButtonX:             rl.GamepadButtonRightFaceLeft,
+	ControllerButtonY:             rl.GamepadButtonRightFaceUp,
+	ControllerButtonBack:          rl.GamepadButtonMiddleLeft,
+	ControllerButtonStart:         rl.GamepadButtonMiddleRight,
+	ControllerButtonLeftShoulder:  rl.GamepadButtonLeftTrigger1,
+	ControllerButtonRightShoulder: rl.GamepadButtonRightTrigger1,
+	ControllerButtonDPadUp:        rl.GamepadButtonLeftFaceUp,
+	ControllerButtonDPadDown:      rl.GamepadButtonLeftFaceDown,
+	ControllerButtonDPadLeft:      rl.GamepadButtonLeftFaceLeft,
+	ControllerButtonDPadRight:     rl.GamepadButtonLeftFaceRight,
+}
+
+// raylibControllerAxes maps every recognized eff.ControllerAxis to its raylib gamepad axis code.
+var raylibControllerAxes = map[ControllerAxis]int32{
+	ControllerAxisLeftX:        rl.GamepadAxisLeftX,
+	ControllerAxisLeftY:        rl.GamepadAxisLeftY,
+	ControllerAxisRightX:       rl.GamepadAxisRightX,
+	ControllerAxisRightY:       rl.GamepadAxisRightY,
+	ControllerAxisTriggerLeft:  rl.GamepadAxisLeftTrigger,
+	ControllerAxisTriggerRight: rl.GamepadAxisRightTrigger,
+}
+
+// controllerAxisDeadZone is the minimum change from the last dispatched value
+// before an axis movement is dispatched, so resting analog sticks don't spam handlers.
+const controllerAxisDeadZone = 0.01
+
+// pollRaylibKeys queues OnKeyDown/OnKeyUp for every recognized key pressed or
+// released this frame. raylib calls must happen on the thread owning the GL
+// context, but the dispatch itself is queued into pending rather than called
+// directly so the caller can run it outside that thread's Do closure.
+func pollRaylibKeys(drawables []Drawable, pending *[]func()) {
+	for key, rlKey := range raylibKeys {
+		key := key
+		if rl.IsKeyPressed(rlKey) {
+			*pending = append(*pending, func() { dispatchKeyDown(drawables, key) })
+		}
+		if rl.IsKeyReleased(rlKey) {
+			*pending = append(*pending, func() { dispatchKeyUp(drawables, key) })
+		}
+	}
+}
+
+// pollRaylibMouse queues OnMouseMove and OnMouseButton for the current frame,
+// tracking the last reported position in lastMouse to detect movement.
+func pollRaylibMouse(drawables []Drawable, lastMouse *Point, pending *[]func()) {
+	mouse := Point{X: int(rl.GetMouseX()), Y: int(rl.GetMouseY())}
+	if mouse != *lastMouse {
+		*pending = append(*pending, func() { dispatchMouseMove(drawables, mouse) })
+		*lastMouse = mouse
+	}
+
+	for button, rlButton := range raylibMouseButtons {
+		button := button
+		if rl.IsMouseButtonPressed(rlButton) {
+			*pending = append(*pending, func() { dispatchMouseButton(drawables, button, true, mouse) })
+		}
+		if rl.IsMouseButtonReleased(rlButton) {
+			*pending = append(*pending, func() { dispatchMouseButton(drawables, button, false, mouse) })
+		}
+	}
+}
+
+// pollRaylibController queues OnControllerButton and OnControllerAxis for gamepad 0,
+// tracking the last reported axis values in lastAxisValues to detect movement.
+func pollRaylibController(drawables []Drawable, lastAxisValues map[ControllerAxis]float32, pending *[]func()) {
+	const gamepad = int32(0)
+	if !rl.IsGamepadAvailable(gamepad) {
+		return
+	}
+
+	for button, rlButton := range raylibControllerButtons {
+		button := button
+		if rl.IsGamepadButtonPressed(gamepad, rlButton) {
+			*pending = append(*pending, func() { dispatchControllerButton(drawables, button, true) })
+		}
+		if rl.IsGamepadButtonReleased(gamepad, rlButton) {
+			*pending = append(*pending, func() { dispatchControllerButton(drawables, button, false) })
+		}
+	}
+
+	for axis, rlAxis := range raylibControllerAxes {
+		value := rl.GetGamepadAxisMovement(gamepad, rlAxis)
+		if value-lastAxisValues[axis] > controllerAxisDeadZone || lastAxisValues[axis]-value > controllerAxisDeadZone {
+			axis := axis
+			dispatchValue := int16(value * 32767)
+			*pending = append(*pending, func() { dispatchControllerAxis(drawables, axis, dispatchValue) })
+			lastAxisValues[axis] = value
+		}
+	}
+}
@@ -0,0 +1,205 @@
+//go:build !raylib
+
+package eff
+
+import "github.com/veandco/go-sdl2/sdl"
+
+// keyFromSym converts an sdl.Keycode to the eff.Key enum, returning KeyUnknown
+// for any keycode we don't recognize.
+func keyFromSym(sym sdl.Keycode) Key {
+	switch sym {
+	case sdl.K_a:
+		return KeyA
+	case sdl.K_b:
+		return KeyB
+	case sdl.K_c:
+		return KeyC
+	case sdl.K_d:
+		return KeyD
+	case sdl.K_e:
+		return KeyE
+	case sdl.K_f:
+		return KeyF
+	case sdl.K_g:
+		return KeyG
+	case sdl.K_h:
+		return KeyH
+	case sdl.K_i:
+		return KeyI
+	case sdl.K_j:
+		return KeyJ
+	case sdl.K_k:
+		return KeyK
+	case sdl.K_l:
+		return KeyL
+	case sdl.K_m:
+		return KeyM
+	case sdl.K_n:
+		return KeyN
+	case sdl.K_o:
+		return KeyO
+	case sdl.K_p:
+		return KeyP
+	case sdl.K_q:
+		return KeyQ
+	case sdl.K_r:
+		return KeyR
+	case sdl.K_s:
+		return KeyS
+	case sdl.K_t:
+		return KeyT
+	case sdl.K_u:
+		return KeyU
+	case sdl.K_v:
+		return KeyV
+	case sdl.K_w:
+		return KeyW
+	case sdl.K_x:
+		return KeyX
+	case sdl.K_y:
+		return KeyY
+	case sdl.K_z:
+		return KeyZ
+	case sdl.K_0:
+		return Key0
+	case sdl.K_1:
+		return Key1
+	case sdl.K_2:
+		return Key2
+	case sdl.K_3:
+		return Key3
+	case sdl.K_4:
+		return Key4
+	case sdl.K_5:
+		return Key5
+	case sdl.K_6:
+		return Key6
+	case sdl.K_7:
+		return Key7
+	case sdl.K_8:
+		return Key8
+	case sdl.K_9:
+		return Key9
+	case sdl.K_SPACE:
+		return KeySpace
+	case sdl.K_ESCAPE:
+		return KeyEscape
+	case sdl.K_RETURN:
+		return KeyReturn
+	case sdl.K_TAB:
+		return KeyTab
+	case sdl.K_BACKSPACE:
+		return KeyBackspace
+	case sdl.K_UP:
+		return KeyUp
+	case sdl.K_DOWN:
+		return KeyDown
+	case sdl.K_LEFT:
+		return KeyLeft
+	case sdl.K_RIGHT:
+		return KeyRight
+	case sdl.K_LSHIFT:
+		return KeyLShift
+	case sdl.K_RSHIFT:
+		return KeyRShift
+	case sdl.K_LCTRL:
+		return KeyLCtrl
+	case sdl.K_RCTRL:
+		return KeyRCtrl
+	case sdl.K_LALT:
+		return KeyLAlt
+	case sdl.K_RALT:
+		return KeyRAlt
+	default:
+		return KeyUnknown
+	}
+}
+
+// mouseButtonFromSDL converts an sdl mouse button code to the eff.MouseButton enum.
+func mouseButtonFromSDL(button uint8) MouseButton {
+	switch button {
+	case sdl.BUTTON_LEFT:
+		return MouseButtonLeft
+	case sdl.BUTTON_MIDDLE:
+		return MouseButtonMiddle
+	case sdl.BUTTON_RIGHT:
+		return MouseButtonRight
+	default:
+		return MouseButtonUnknown
+	}
+}
+
+// controllerButtonFromSDL converts an sdl.ControllerButtonEvent button code to the eff.ControllerButton enum.
+func controllerButtonFromSDL(button uint8) ControllerButton {
+	switch button {
+	case sdl.CONTROLLER_BUTTON_A:
+		return ControllerButtonA
+	case sdl.CONTROLLER_BUTTON_B:
+		return ControllerButtonB
+	case sdl.CONTROLLER_BUTTON_X:
+		return ControllerButtonX
+	case sdl.CONTROLLER_BUTTON_Y:
+		return ControllerButtonY
+	case sdl.CONTROLLER_BUTTON_BACK:
+		return ControllerButtonBack
+	case sdl.CONTROLLER_BUTTON_START:
+		return ControllerButtonStart
+	case sdl.CONTROLLER_BUTTON_LEFTSHOULDER:
+		return ControllerButtonLeftShoulder
+	case sdl.CONTROLLER_BUTTON_RIGHTSHOULDER:
+		return ControllerButtonRightShoulder
+	case sdl.CONTROLLER_BUTTON_DPAD_UP:
+		return ControllerButtonDPadUp
+	case sdl.CONTROLLER_BUTTON_DPAD_DOWN:
+		return ControllerButtonDPadDown
+	case sdl.CONTROLLER_BUTTON_DPAD_LEFT:
+		return ControllerButtonDPadLeft
+	case sdl.CONTROLLER_BUTTON_DPAD_RIGHT:
+		return ControllerButtonDPadRight
+	default:
+		return ControllerButtonUnknown
+	}
+}
+
+// openController opens the joystick at the given device index as a game
+// controller, if SDL recognizes it as one, so it starts generating
+// ControllerButtonEvent/ControllerAxisEvent. Must run on the SDL thread
+// (inside Do/DoErr). A joystick index that's already open or isn't a
+// recognized controller is silently skipped.
+func (sdlCanvas *SDLCanvas) openController(joystickIndex int) {
+	if !sdl.IsGameController(joystickIndex) {
+		return
+	}
+	controller := sdl.GameControllerOpen(joystickIndex)
+	if controller == nil {
+		return
+	}
+	for _, open := range sdlCanvas.controllers {
+		if open == controller {
+			// SDL hands back the same controller with its refcount raised.
+			controller.Close()
+			return
+		}
+	}
+	sdlCanvas.controllers = append(sdlCanvas.controllers, controller)
+}
+
+// controllerAxisFromSDL converts an sdl.ControllerAxisEvent axis code to the eff.ControllerAxis enum.
+func controllerAxisFromSDL(axis uint8) ControllerAxis {
+	switch axis {
+	case sdl.CONTROLLER_AXIS_LEFTX:
+		return ControllerAxisLeftX
+	case sdl.CONTROLLER_AXIS_LEFTY:
+		return ControllerAxisLeftY
+	case sdl.CONTROLLER_AXIS_RIGHTX:
+		return ControllerAxisRightX
+	case sdl.CONTROLLER_AXIS_RIGHTY:
+		return ControllerAxisRightY
+	case sdl.CONTROLLER_AXIS_TRIGGERLEFT:
+		return ControllerAxisTriggerLeft
+	case sdl.CONTROLLER_AXIS_TRIGGERRIGHT:
+		return ControllerAxisTriggerRight
+	default:
+		return ControllerAxisUnknown
+	}
+}
@@ -0,0 +1,21 @@
+package eff
+
+import "time"
+
+// TickUpdater is an optional Drawable interface for fixed-timestep updates.
+// If a Drawable implements it, UpdateTick replaces Update and is called zero
+// or more times per frame with a constant dt, at the rate set by
+// Canvas.SetTickRate, keeping simulation and animation independent of the
+// render frame rate.
+type TickUpdater interface {
+	UpdateTick(canvas Canvas, dt time.Duration)
+}
+
+// InterpolatedDrawer is an optional Drawable interface for receiving how far
+// the render is between the previous and current simulation tick. If a
+// Drawable implements it, DrawAlpha replaces Draw and is called once per
+// frame with alpha in [0,1), the fraction of a tick elapsed since the last
+// UpdateTick.
+type InterpolatedDrawer interface {
+	DrawAlpha(canvas Canvas, alpha float64)
+}